@@ -0,0 +1,41 @@
+// Package algo provides slices-style generic algorithms for
+// syncslice.Slice that need a comparable or ordered element type. Slice[T]
+// itself is declared with T any, so these can't be methods on Slice
+// without narrowing its type parameter; instead they're top-level
+// functions, mirroring how the standard library's slices package
+// separates Contains/Index/BinarySearch from their Func counterparts.
+// Each function takes a snapshot of its Slice argument(s) via GetSlice
+// before operating, so it sees a consistent view but does not hold the
+// Slice's lock for the duration of the call.
+package algo
+
+import (
+	"cmp"
+	"slices"
+
+	syncslice "github.com/IIGabriel/sync-slice/pkg"
+)
+
+// Contains reports whether v is present in s.
+func Contains[T comparable](s *syncslice.Slice[T], v T) bool {
+	return slices.Contains(s.GetSlice(), v)
+}
+
+// Index returns the index of the first occurrence of v in s, or -1 if v
+// is not present.
+func Index[T comparable](s *syncslice.Slice[T], v T) int {
+	return slices.Index(s.GetSlice(), v)
+}
+
+// BinarySearch searches for target in s, which must be sorted in
+// ascending order, and returns the position where target is found, or
+// where it would be inserted, and whether it was found.
+func BinarySearch[T cmp.Ordered](s *syncslice.Slice[T], target T) (int, bool) {
+	return slices.BinarySearch(s.GetSlice(), target)
+}
+
+// Equal reports whether a and b contain the same elements in the same
+// order.
+func Equal[T comparable](a, b *syncslice.Slice[T]) bool {
+	return slices.Equal(a.GetSlice(), b.GetSlice())
+}
@@ -1,20 +1,59 @@
 package syncslice
 
 import (
+	"iter"
+	"slices"
 	"sync"
 	"sync/atomic"
 )
 
 // Slice is a concurrent-safe, dynamically-sized slice.
+//
+// Reads (Get, Length, Range, GetSlice, All, Values, Backward) are
+// lock-free: they Load the current backing array once and then operate
+// on that snapshot, which never changes in place, so they see a
+// consistent view even if a writer mutates the slice concurrently.
+// Writers serialize through mu, build a new backing array, and Store it
+// atomically.
 type Slice[T any] struct {
-	mu    sync.Mutex
-	slice []T
-	len   int32
+	mu   sync.Mutex
+	data atomic.Pointer[[]T]
+
+	cond   *sync.Cond
+	subs   map[int]chan Event[T]
+	subSeq int
 }
 
 // New creates a new concurrent-safe slice.
 func New[T any]() *Slice[T] {
-	return &Slice[T]{}
+	s := &Slice[T]{
+		subs: make(map[int]chan Event[T]),
+	}
+	s.cond = sync.NewCond(&s.mu)
+	empty := make([]T, 0)
+	s.data.Store(&empty)
+	return s
+}
+
+// publish wakes any goroutine blocked in WaitForLen or Pop and delivers
+// ev to every current Subscribe channel on a best-effort basis. It must
+// be called with mu held.
+func (s *Slice[T]) publish(ev Event[T]) {
+	s.cond.Broadcast()
+	for _, ch := range s.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// load returns the current backing array. The stored pointer is never
+// nil: New always installs a pointer to an empty slice, and every write
+// stores a pointer to its replacement. The slice itself can be nil if Do's
+// closure returned nil.
+func (s *Slice[T]) load() []T {
+	return *s.data.Load()
 }
 
 // Append adds an element to the end of the slice.
@@ -22,25 +61,30 @@ func (s *Slice[T]) Append(value T) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.slice = append(s.slice, value)
-	atomic.AddInt32(&s.len, 1)
+	old := s.load()
+	next := make([]T, len(old)+1)
+	copy(next, old)
+	next[len(old)] = value
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventAppend, Index: len(old), Value: value})
 }
 
 // Get retrieves an element at a given index.
 // If the index is out of bounds, it returns the zero value for the type and false.
 func (s *Slice[T]) Get(index int) (T, bool) {
-	if index < 0 || index >= int(atomic.LoadInt32(&s.len)) {
+	snapshot := s.load()
+	if index < 0 || index >= len(snapshot) {
 		var zero T
 		return zero, false
 	}
 
-	return s.slice[index], true
+	return snapshot[index], true
 }
 
 // GetUnsafe retrieves an element at a given index without checking for out-of-bounds.
 // This method is unsafe and can Panic if the index is out of bounds.
 func (s *Slice[T]) GetUnsafe(index int) T {
-	return s.slice[index]
+	return s.load()[index]
 }
 
 // Set updates the element at a given index.
@@ -49,11 +93,16 @@ func (s *Slice[T]) Set(index int, value T) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if index < 0 || index >= int(s.len) {
+	old := s.load()
+	if index < 0 || index >= len(old) {
 		return false
 	}
 
-	s.slice[index] = value
+	next := make([]T, len(old))
+	copy(next, old)
+	next[index] = value
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventSet, Index: index, Value: value})
 	return true
 }
 
@@ -63,12 +112,17 @@ func (s *Slice[T]) SetUnsafe(index int, value T) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.slice[index] = value
+	old := s.load()
+	next := make([]T, len(old))
+	copy(next, old)
+	next[index] = value
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventSet, Index: index, Value: value})
 }
 
 // Length returns the current length of the slice.
 func (s *Slice[T]) Length() int {
-	return int(atomic.LoadInt32(&s.len))
+	return len(s.load())
 }
 
 // Remove removes the element at a given index.
@@ -77,12 +131,17 @@ func (s *Slice[T]) Remove(index int) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if index < 0 || index >= int(s.len) {
+	old := s.load()
+	if index < 0 || index >= len(old) {
 		return false
 	}
 
-	s.slice = append(s.slice[:index], s.slice[index+1:]...)
-	atomic.AddInt32(&s.len, -1)
+	removed := old[index]
+	next := make([]T, 0, len(old)-1)
+	next = append(next, old[:index]...)
+	next = append(next, old[index+1:]...)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventRemove, Index: index, Value: removed})
 	return true
 }
 
@@ -92,37 +151,48 @@ func (s *Slice[T]) RemoveUnsafe(index int) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.slice = append(s.slice[:index], s.slice[index+1:]...)
-	atomic.AddInt32(&s.len, -1)
+	old := s.load()
+	removed := old[index]
+	next := make([]T, 0, len(old)-1)
+	next = append(next, old[:index]...)
+	next = append(next, old[index+1:]...)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventRemove, Index: index, Value: removed})
 }
 
-// Range calls a function for each element in the slice.
+// Range calls a function for each element in the slice, iterating over a
+// consistent snapshot taken when Range is called, even if another
+// goroutine mutates the slice concurrently.
 // If the function returns false, it stops the iteration.
+//
+// Range never holds a lock while calling f, so f may safely call back
+// into s without deadlocking, and a panic from f propagates to the
+// caller without leaving s locked.
 func (s *Slice[T]) Range(f func(index int, value T) bool) {
-	for i, v := range s.slice {
+	snapshot := s.load()
+	for i, v := range snapshot {
 		if !f(i, v) {
 			break
 		}
 	}
 }
 
-// SetSlice replaces the internal slice with the provided slice.
+// SetSlice replaces the internal slice with a copy of the provided slice.
 func (s *Slice[T]) SetSlice(newSlice []T) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.slice = make([]T, len(newSlice))
-	copy(s.slice, newSlice)
-	atomic.StoreInt32(&s.len, int32(len(newSlice)))
+	next := make([]T, len(newSlice))
+	copy(next, newSlice)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
 }
 
 // GetSlice returns a copy of the internal slice.
 func (s *Slice[T]) GetSlice() []T {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	copiedSlice := make([]T, len(s.slice))
-	copy(copiedSlice, s.slice)
+	snapshot := s.load()
+	copiedSlice := make([]T, len(snapshot))
+	copy(copiedSlice, snapshot)
 	return copiedSlice
 }
 
@@ -132,11 +202,16 @@ func (s *Slice[T]) ManipulateAtIndex(index int, manipulateFunc func(*T)) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if index < 0 || index >= int(s.len) {
+	old := s.load()
+	if index < 0 || index >= len(old) {
 		return false
 	}
-	manipulateFunc(&s.slice[index])
 
+	next := make([]T, len(old))
+	copy(next, old)
+	manipulateFunc(&next[index])
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventSet, Index: index, Value: next[index]})
 	return true
 }
 
@@ -146,5 +221,257 @@ func (s *Slice[T]) ManipulateAtIndexUnsafe(index int, manipulateFunc func(*T)) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	manipulateFunc(&s.slice[index])
+	old := s.load()
+	next := make([]T, len(old))
+	copy(next, old)
+	manipulateFunc(&next[index])
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventSet, Index: index, Value: next[index]})
+}
+
+// All returns a range-over-func iterator over the slice's index-value
+// pairs, in order from index 0. It iterates over the snapshot loaded when
+// All is called, so the loop body may safely call back into s without
+// deadlocking, a panic from the body propagates without leaving s
+// locked, and the iterator stops as soon as yield returns false.
+func (s *Slice[T]) All() iter.Seq2[int, T] {
+	snapshot := s.load()
+	return func(yield func(int, T) bool) {
+		for i, v := range snapshot {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a range-over-func iterator over the slice's values, in
+// order from index 0. Like All, it iterates over the snapshot loaded when
+// Values is called and honors the same panic-safety and yield contract.
+func (s *Slice[T]) Values() iter.Seq[T] {
+	snapshot := s.load()
+	return func(yield func(T) bool) {
+		for _, v := range snapshot {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a range-over-func iterator over the slice's
+// index-value pairs, in reverse order starting from the last index. Like
+// All, it iterates over the snapshot loaded when Backward is called and
+// honors the same panic-safety and yield contract.
+func (s *Slice[T]) Backward() iter.Seq2[int, T] {
+	snapshot := s.load()
+	return func(yield func(int, T) bool) {
+		for i := len(snapshot) - 1; i >= 0; i-- {
+			if !yield(i, snapshot[i]) {
+				return
+			}
+		}
+	}
+}
+
+// AppendSeq appends every value produced by seq to the slice.
+func (s *Slice[T]) AppendSeq(seq iter.Seq[T]) {
+	for v := range seq {
+		s.Append(v)
+	}
+}
+
+// Collect returns a new Slice containing the values produced by seq,
+// mirroring the standard library's slices.Collect.
+func Collect[T any](seq iter.Seq[T]) *Slice[T] {
+	s := New[T]()
+	s.AppendSeq(seq)
+	return s
+}
+
+// IndexFunc returns the index of the first element for which f returns
+// true, or -1 if no element satisfies f. It scans the snapshot loaded
+// when IndexFunc is called.
+func (s *Slice[T]) IndexFunc(f func(T) bool) int {
+	return slices.IndexFunc(s.load(), f)
+}
+
+// DeleteFunc removes all elements for which f returns true, installing
+// the result as the new backing array under a single lock. See
+// slices.DeleteFunc.
+func (s *Slice[T]) DeleteFunc(f func(T) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	cp := make([]T, len(old))
+	copy(cp, old)
+	next := slices.DeleteFunc(cp, f)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
+}
+
+// CompactFunc replaces consecutive runs of elements for which eq returns
+// true with a single copy of the first element, installing the result as
+// the new backing array under a single lock. See slices.CompactFunc.
+func (s *Slice[T]) CompactFunc(eq func(a, b T) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	cp := make([]T, len(old))
+	copy(cp, old)
+	next := slices.CompactFunc(cp, eq)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
+}
+
+// SortFunc sorts the slice using cmp to compare elements, installing the
+// sorted result as the new backing array under a single lock. See
+// slices.SortFunc.
+func (s *Slice[T]) SortFunc(cmp func(a, b T) int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	next := make([]T, len(old))
+	copy(next, old)
+	slices.SortFunc(next, cmp)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
+}
+
+// SortStableFunc sorts the slice using cmp, keeping equal elements in
+// their original order, installing the sorted result as the new backing
+// array under a single lock. See slices.SortStableFunc.
+func (s *Slice[T]) SortStableFunc(cmp func(a, b T) int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	next := make([]T, len(old))
+	copy(next, old)
+	slices.SortStableFunc(next, cmp)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
+}
+
+// BinarySearchFunc searches for target in a slice sorted in ascending
+// order as determined by cmp, returning the position where target is
+// found, or where it would be inserted, and whether it was found. It
+// searches the snapshot loaded when BinarySearchFunc is called. See
+// slices.BinarySearchFunc.
+func (s *Slice[T]) BinarySearchFunc(target T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(s.load(), target, cmp)
+}
+
+// Insert inserts values at the given index, installing the result as the
+// new backing array under a single lock. It returns false if index is
+// out of bounds. See slices.Insert.
+func (s *Slice[T]) Insert(index int, values ...T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	if index < 0 || index > len(old) {
+		return false
+	}
+
+	cp := make([]T, len(old))
+	copy(cp, old)
+	next := slices.Insert(cp, index, values...)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
+	return true
+}
+
+// Delete removes the elements in the range [i:j) from the slice,
+// installing the result as the new backing array under a single lock. It
+// returns false if the range is invalid. See slices.Delete.
+func (s *Slice[T]) Delete(i, j int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	if i < 0 || j > len(old) || i > j {
+		return false
+	}
+
+	cp := make([]T, len(old))
+	copy(cp, old)
+	next := slices.Delete(cp, i, j)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
+	return true
+}
+
+// Replace replaces the elements in the range [i:j) with values,
+// installing the result as the new backing array under a single lock. It
+// returns false if the range is invalid. See slices.Replace.
+func (s *Slice[T]) Replace(i, j int, values ...T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	if i < 0 || j > len(old) || i > j {
+		return false
+	}
+
+	cp := make([]T, len(old))
+	copy(cp, old)
+	next := slices.Replace(cp, i, j, values...)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
+	return true
+}
+
+// Reverse reverses the elements of the slice, installing the result as
+// the new backing array under a single lock.
+func (s *Slice[T]) Reverse() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	next := make([]T, len(old))
+	copy(next, old)
+	slices.Reverse(next)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
+}
+
+// Clone returns a new Slice containing a copy of the elements, mirroring
+// slices.Clone.
+func (s *Slice[T]) Clone() *Slice[T] {
+	clone := New[T]()
+	clone.SetSlice(s.GetSlice())
+	return clone
+}
+
+// EqualFunc reports whether s and other contain the same number of
+// elements and eq returns true for every pair of elements at
+// corresponding indices. It compares the snapshots loaded when EqualFunc
+// is called. See slices.EqualFunc.
+func (s *Slice[T]) EqualFunc(other *Slice[T], eq func(a, b T) bool) bool {
+	return slices.EqualFunc(s.load(), other.load(), eq)
+}
+
+// Do acquires the lock once, hands a copy of the underlying slice to f,
+// and installs whatever f returns as the new backing array. It lets
+// callers perform multi-step mutations such as sorting, bulk inserts, or
+// filtering as a single locked operation instead of paying a lock per
+// Range/Set/ManipulateAtIndex call.
+//
+// The slice passed to f must not escape the closure: it is only valid
+// until f returns, and retaining or mutating it afterwards races with
+// other goroutines.
+func (s *Slice[T]) Do(f func(raw []T) []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old := s.load()
+	cp := make([]T, len(old))
+	copy(cp, old)
+	next := f(cp)
+	s.data.Store(&next)
+	s.publish(Event[T]{Kind: EventMutate})
 }
@@ -0,0 +1,129 @@
+package syncslice
+
+import "context"
+
+// EventKind identifies the kind of mutation that produced an Event.
+type EventKind int
+
+const (
+	// EventAppend is published when an element is appended.
+	EventAppend EventKind = iota
+	// EventSet is published when an element at an existing index is
+	// replaced, via Set, SetUnsafe, or a ManipulateAtIndex variant.
+	EventSet
+	// EventRemove is published when an element is removed.
+	EventRemove
+	// EventMutate is published by bulk operations (SetSlice, Do, Insert,
+	// Delete, Replace, Reverse, SortFunc, and similar) whose effect isn't
+	// a single index/value pair.
+	EventMutate
+)
+
+// Event describes a single mutation published to Subscribe channels and
+// used to wake WaitForLen/Pop. Index and Value are only meaningful for
+// EventAppend, EventSet, and EventRemove.
+type Event[T any] struct {
+	Kind  EventKind
+	Index int
+	Value T
+}
+
+// WaitForLen blocks until the slice's length is at least n, or until ctx
+// is done, whichever happens first.
+func (s *Slice[T]) WaitForLen(ctx context.Context, n int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			s.cond.Broadcast()
+			s.mu.Unlock()
+		case <-done:
+		}
+	}()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for len(s.load()) < n {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		s.cond.Wait()
+	}
+	return nil
+}
+
+// Pop blocks until the slice is non-empty, then removes and returns the
+// element at index 0. It returns ctx.Err() if ctx is done before an
+// element becomes available.
+func (s *Slice[T]) Pop(ctx context.Context) (T, error) {
+	if err := ctx.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			s.cond.Broadcast()
+			s.mu.Unlock()
+		case <-done:
+		}
+	}()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for {
+		old := s.load()
+		if len(old) > 0 {
+			v := old[0]
+			next := make([]T, len(old)-1)
+			copy(next, old[1:])
+			s.data.Store(&next)
+			s.publish(Event[T]{Kind: EventRemove, Index: 0, Value: v})
+			return v, nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			var zero T
+			return zero, err
+		}
+		s.cond.Wait()
+	}
+}
+
+// Subscribe registers a new subscriber and returns a channel of Events
+// describing subsequent mutations, along with an unsubscribe function
+// that closes the channel. Events are delivered on a best-effort basis:
+// if the channel's buffer is full, the event is dropped rather than
+// blocking the mutating goroutine.
+func (s *Slice[T]) Subscribe() (<-chan Event[T], func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.subSeq
+	s.subSeq++
+	ch := make(chan Event[T], 16)
+	s.subs[id] = ch
+
+	unsubscribe := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.subs[id]; ok {
+			delete(s.subs, id)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
@@ -0,0 +1,76 @@
+package syncslice_test
+
+import (
+	"testing"
+
+	syncslice "github.com/IIGabriel/sync-slice/pkg"
+	"github.com/IIGabriel/sync-slice/pkg/algo"
+)
+
+// TestAlgoContains tests the algo.Contains function.
+func TestAlgoContains(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	if !algo.Contains(s, 2) {
+		t.Error("Expected Contains to find 2")
+	}
+
+	if algo.Contains(s, 4) {
+		t.Error("Expected Contains to not find 4")
+	}
+}
+
+// TestAlgoIndex tests the algo.Index function.
+func TestAlgoIndex(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	if idx := algo.Index(s, 3); idx != 2 {
+		t.Errorf("Expected index 2, got %d", idx)
+	}
+
+	if idx := algo.Index(s, 4); idx != -1 {
+		t.Errorf("Expected index -1 for missing value, got %d", idx)
+	}
+}
+
+// TestAlgoBinarySearch tests the algo.BinarySearch function.
+func TestAlgoBinarySearch(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(3)
+	s.Append(5)
+
+	if idx, found := algo.BinarySearch(s, 3); !found || idx != 1 {
+		t.Errorf("Expected found at index 1, got index %d found %v", idx, found)
+	}
+
+	if idx, found := algo.BinarySearch(s, 4); found || idx != 2 {
+		t.Errorf("Expected not found with insertion point 2, got index %d found %v", idx, found)
+	}
+}
+
+// TestAlgoEqual tests the algo.Equal function.
+func TestAlgoEqual(t *testing.T) {
+	a := syncslice.New[int]()
+	a.Append(1)
+	a.Append(2)
+
+	b := syncslice.New[int]()
+	b.Append(1)
+	b.Append(2)
+
+	if !algo.Equal(a, b) {
+		t.Error("Expected a and b to be equal")
+	}
+
+	b.Append(3)
+	if algo.Equal(a, b) {
+		t.Error("Expected a and b to be unequal after appending to b")
+	}
+}
@@ -0,0 +1,132 @@
+package syncslice_test
+
+import (
+	"testing"
+
+	syncslice "github.com/IIGabriel/sync-slice/pkg"
+)
+
+// TestRangeStopsOnFalseReturn mirrors the Go runtime's "BadOfSliceIndex"
+// pattern: once f returns false, Range must not call it again.
+func TestRangeStopsOnFalseReturn(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	var calls []int
+	s.Range(func(index int, value int) bool {
+		calls = append(calls, value)
+		return false
+	})
+
+	if len(calls) != 1 {
+		t.Errorf("Expected exactly 1 call after returning false, got %d", len(calls))
+	}
+}
+
+// TestRangePanicSafety mirrors the "PanickyIterator" pattern: a panic
+// inside the callback must propagate without leaving s in a locked
+// state, so subsequent operations on s still work.
+func TestRangePanicSafety(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected Range's callback panic to propagate")
+			}
+		}()
+		s.Range(func(index int, value int) bool {
+			panic("boom")
+		})
+	}()
+
+	// If Range left s locked, this would deadlock.
+	s.Append(3)
+	if s.Length() != 3 {
+		t.Errorf("Expected length 3 after panic recovery, got %d", s.Length())
+	}
+}
+
+// TestRangeReentrant verifies that calling back into s from within
+// Range's callback does not deadlock, since Range holds no lock while
+// invoking f.
+func TestRangeReentrant(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+
+	var seen []int
+	s.Range(func(index int, value int) bool {
+		seen = append(seen, s.GetSlice()...)
+		return true
+	})
+
+	if len(seen) == 0 {
+		t.Error("Expected reentrant GetSlice calls to succeed without deadlocking")
+	}
+}
+
+// TestAllStopsOnFalseReturn verifies the All iterator honors the same
+// yield contract as Range.
+func TestAllStopsOnFalseReturn(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	var calls int
+	s.All()(func(index int, value int) bool {
+		calls++
+		return false
+	})
+
+	if calls != 1 {
+		t.Errorf("Expected exactly 1 call after returning false, got %d", calls)
+	}
+}
+
+// TestAllPanicSafety verifies that a panic from All's yield callback
+// propagates without leaving s locked.
+func TestAllPanicSafety(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected All's callback panic to propagate")
+			}
+		}()
+		s.All()(func(index int, value int) bool {
+			panic("boom")
+		})
+	}()
+
+	s.Append(2)
+	if s.Length() != 2 {
+		t.Errorf("Expected length 2 after panic recovery, got %d", s.Length())
+	}
+}
+
+// TestAllReentrant verifies that calling back into s from within All's
+// yield callback does not deadlock.
+func TestAllReentrant(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+
+	var seen int
+	s.All()(func(index int, value int) bool {
+		s.Append(value * 10)
+		seen++
+		return true
+	})
+
+	if seen != 2 {
+		t.Errorf("Expected to visit 2 elements from the original snapshot, got %d", seen)
+	}
+}
@@ -277,3 +277,338 @@ func TestManipulateAtIndexUnsafe(t *testing.T) {
 		t.Errorf("Expected value 10 at index 1, got %d", val)
 	}
 }
+
+// TestAll tests the All range-over-func iterator.
+func TestAll(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	var indices []int
+	var values []int
+	for i, v := range s.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(indices, []int{0, 1, 2}) {
+		t.Errorf("Expected indices [0 1 2], got %v", indices)
+	}
+	if !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("Expected values [1 2 3], got %v", values)
+	}
+
+	// Early termination.
+	values = nil
+	for _, v := range s.All() {
+		values = append(values, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(values, []int{1, 2}) {
+		t.Errorf("Expected early termination values [1 2], got %v", values)
+	}
+}
+
+// TestValues tests the Values range-over-func iterator.
+func TestValues(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	var values []int
+	for v := range s.Values() {
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("Expected values [1 2 3], got %v", values)
+	}
+}
+
+// TestBackward tests the Backward range-over-func iterator.
+func TestBackward(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	var indices []int
+	var values []int
+	for i, v := range s.Backward() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(indices, []int{2, 1, 0}) {
+		t.Errorf("Expected indices [2 1 0], got %v", indices)
+	}
+	if !reflect.DeepEqual(values, []int{3, 2, 1}) {
+		t.Errorf("Expected values [3 2 1], got %v", values)
+	}
+}
+
+// TestAppendSeq tests the AppendSeq helper.
+func TestAppendSeq(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+
+	seq := func(yield func(int) bool) {
+		for _, v := range []int{2, 3} {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	s.AppendSeq(seq)
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after AppendSeq, got %v", s.GetSlice())
+	}
+}
+
+// TestCollect tests the Collect helper.
+func TestCollect(t *testing.T) {
+	seq := func(yield func(int) bool) {
+		for _, v := range []int{4, 5, 6} {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	s := syncslice.Collect(seq)
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{4, 5, 6}) {
+		t.Errorf("Expected [4 5 6] from Collect, got %v", s.GetSlice())
+	}
+}
+
+// TestIndexFunc tests the IndexFunc method.
+func TestIndexFunc(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	if idx := s.IndexFunc(func(v int) bool { return v == 2 }); idx != 1 {
+		t.Errorf("Expected index 1, got %d", idx)
+	}
+
+	if idx := s.IndexFunc(func(v int) bool { return v == 4 }); idx != -1 {
+		t.Errorf("Expected index -1 for missing value, got %d", idx)
+	}
+}
+
+// TestDeleteFunc tests the DeleteFunc method.
+func TestDeleteFunc(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+	s.Append(4)
+
+	s.DeleteFunc(func(v int) bool { return v%2 == 0 })
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{1, 3}) {
+		t.Errorf("Expected [1 3] after DeleteFunc, got %v", s.GetSlice())
+	}
+}
+
+// TestCompactFunc tests the CompactFunc method.
+func TestCompactFunc(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(1)
+	s.Append(2)
+	s.Append(2)
+	s.Append(2)
+	s.Append(3)
+
+	s.CompactFunc(func(a, b int) bool { return a == b })
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after CompactFunc, got %v", s.GetSlice())
+	}
+}
+
+// TestSortFunc tests the SortFunc method.
+func TestSortFunc(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(3)
+	s.Append(1)
+	s.Append(2)
+
+	s.SortFunc(func(a, b int) int { return a - b })
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after SortFunc, got %v", s.GetSlice())
+	}
+}
+
+// TestSortStableFunc tests the SortStableFunc method.
+func TestSortStableFunc(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(3)
+	s.Append(1)
+	s.Append(2)
+
+	s.SortStableFunc(func(a, b int) int { return a - b })
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] after SortStableFunc, got %v", s.GetSlice())
+	}
+}
+
+// TestBinarySearchFunc tests the BinarySearchFunc method.
+func TestBinarySearchFunc(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(3)
+	s.Append(5)
+
+	cmp := func(a, b int) int { return a - b }
+
+	if idx, found := s.BinarySearchFunc(3, cmp); !found || idx != 1 {
+		t.Errorf("Expected found at index 1, got index %d found %v", idx, found)
+	}
+
+	if idx, found := s.BinarySearchFunc(4, cmp); found || idx != 2 {
+		t.Errorf("Expected not found with insertion point 2, got index %d found %v", idx, found)
+	}
+}
+
+// TestInsert tests the Insert method.
+func TestInsert(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(4)
+
+	if !s.Insert(1, 2, 3) {
+		t.Error("Expected Insert to succeed")
+	}
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4] after Insert, got %v", s.GetSlice())
+	}
+
+	if s.Insert(10, 5) {
+		t.Error("Expected Insert to fail for out of bounds index")
+	}
+}
+
+// TestDelete tests the Delete method.
+func TestDelete(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+	s.Append(4)
+
+	if !s.Delete(1, 3) {
+		t.Error("Expected Delete to succeed")
+	}
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{1, 4}) {
+		t.Errorf("Expected [1 4] after Delete, got %v", s.GetSlice())
+	}
+
+	if s.Delete(0, 10) {
+		t.Error("Expected Delete to fail for out of bounds range")
+	}
+}
+
+// TestReplace tests the Replace method.
+func TestReplace(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	if !s.Replace(1, 2, 20, 21) {
+		t.Error("Expected Replace to succeed")
+	}
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{1, 20, 21, 3}) {
+		t.Errorf("Expected [1 20 21 3] after Replace, got %v", s.GetSlice())
+	}
+
+	if s.Replace(0, 10, 1) {
+		t.Error("Expected Replace to fail for out of bounds range")
+	}
+}
+
+// TestReverse tests the Reverse method.
+func TestReverse(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	s.Reverse()
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{3, 2, 1}) {
+		t.Errorf("Expected [3 2 1] after Reverse, got %v", s.GetSlice())
+	}
+}
+
+// TestClone tests the Clone method.
+func TestClone(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+
+	clone := s.Clone()
+	s.Append(3)
+
+	if !reflect.DeepEqual(clone.GetSlice(), []int{1, 2}) {
+		t.Errorf("Expected clone to stay [1 2], got %v", clone.GetSlice())
+	}
+}
+
+// TestEqualFunc tests the EqualFunc method.
+func TestEqualFunc(t *testing.T) {
+	a := syncslice.New[int]()
+	a.Append(1)
+	a.Append(2)
+
+	b := syncslice.New[int]()
+	b.Append(1)
+	b.Append(2)
+
+	if !a.EqualFunc(b, func(x, y int) bool { return x == y }) {
+		t.Error("Expected a and b to be equal")
+	}
+
+	b.Append(3)
+	if a.EqualFunc(b, func(x, y int) bool { return x == y }) {
+		t.Error("Expected a and b to be unequal after appending to b")
+	}
+}
+
+// TestDo tests the Do method.
+func TestDo(t *testing.T) {
+	s := syncslice.New[int]()
+	s.Append(1)
+	s.Append(2)
+	s.Append(3)
+
+	s.Do(func(raw []int) []int {
+		raw = append(raw, 4)
+		for i := range raw {
+			raw[i] *= 2
+		}
+		return raw
+	})
+
+	if !reflect.DeepEqual(s.GetSlice(), []int{2, 4, 6, 8}) {
+		t.Errorf("Expected [2 4 6 8] after Do, got %v", s.GetSlice())
+	}
+
+	if s.Length() != 4 {
+		t.Errorf("Expected length 4 after Do, got %d", s.Length())
+	}
+}
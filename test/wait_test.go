@@ -0,0 +1,122 @@
+package syncslice_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	syncslice "github.com/IIGabriel/sync-slice/pkg"
+)
+
+// TestWaitForLen tests that WaitForLen unblocks once the slice reaches
+// the requested length.
+func TestWaitForLen(t *testing.T) {
+	s := syncslice.New[int]()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.WaitForLen(context.Background(), 2)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	s.Append(1)
+	s.Append(2)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Expected WaitForLen to succeed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WaitForLen did not unblock after reaching the target length")
+	}
+}
+
+// TestWaitForLenCancel tests that WaitForLen returns the context error
+// when the context is cancelled before the target length is reached.
+func TestWaitForLenCancel(t *testing.T) {
+	s := syncslice.New[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := s.WaitForLen(ctx, 1)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+// TestPop tests that Pop blocks until an element is available, then
+// removes and returns it.
+func TestPop(t *testing.T) {
+	s := syncslice.New[int]()
+
+	type result struct {
+		val int
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		v, err := s.Pop(context.Background())
+		done <- result{v, err}
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	s.Append(42)
+
+	select {
+	case r := <-done:
+		if r.err != nil || r.val != 42 {
+			t.Errorf("Expected (42, nil), got (%d, %v)", r.val, r.err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Pop did not unblock after an element was appended")
+	}
+
+	if s.Length() != 0 {
+		t.Errorf("Expected length 0 after Pop, got %d", s.Length())
+	}
+}
+
+// TestPopCancel tests that Pop returns the context error when cancelled
+// before an element becomes available.
+func TestPopCancel(t *testing.T) {
+	s := syncslice.New[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := s.Pop(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+// TestSubscribe tests that subscribers receive events for subsequent
+// mutations, and stop receiving after unsubscribing.
+func TestSubscribe(t *testing.T) {
+	s := syncslice.New[int]()
+	events, unsubscribe := s.Subscribe()
+	defer unsubscribe()
+
+	s.Append(1)
+
+	select {
+	case ev := <-events:
+		if ev.Kind != syncslice.EventAppend || ev.Value != 1 {
+			t.Errorf("Expected append event with value 1, got %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected to receive an event after Append")
+	}
+
+	unsubscribe()
+	s.Append(2)
+
+	select {
+	case ev, ok := <-events:
+		if ok {
+			t.Errorf("Expected channel to be closed after unsubscribe, got %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected channel to be closed after unsubscribe")
+	}
+}